@@ -0,0 +1,58 @@
+// Copyright (c) 2018 Beta Kuang
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package tigo
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/gif"
+	"image/jpeg"
+	"image/png"
+	"io"
+
+	xbmp "golang.org/x/image/bmp"
+)
+
+func init() {
+	// image/png, image/jpeg, and image/gif self-register with
+	// image.RegisterFormat; golang.org/x/image/bmp does not, so register it
+	// here to let LoadImageReader/LoadImageBytes auto-detect BMP too.
+	image.RegisterFormat("bmp", "BM", xbmp.Decode, xbmp.DecodeConfig)
+}
+
+// LoadImageReader loads an image from r, auto-detecting PNG, JPEG, GIF
+// (first frame), or BMP.
+func LoadImageReader(r io.Reader) (*Bitmap, error) {
+	img, _, err := image.Decode(r)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode image: %w", err)
+	}
+	return FromImage(img), nil
+}
+
+// LoadImageBytes loads an image from raw bytes, auto-detecting PNG, JPEG,
+// GIF (first frame), or BMP.
+func LoadImageBytes(data []byte) (*Bitmap, error) {
+	return LoadImageReader(bytes.NewReader(data))
+}
+
+// SaveImageWriter saves bmp to w, encoded as format ("png", "jpeg", "gif", or "bmp").
+func SaveImageWriter(w io.Writer, bmp *Bitmap, format string) error {
+	img := bmp.RGBA()
+	switch format {
+	case "png":
+		return png.Encode(w, img)
+	case "jpeg", "jpg":
+		return jpeg.Encode(w, img, nil)
+	case "gif":
+		return gif.Encode(w, img, nil)
+	case "bmp":
+		return xbmp.Encode(w, img)
+	default:
+		return fmt.Errorf("unsupported image format: %s", format)
+	}
+}
@@ -0,0 +1,58 @@
+// Copyright (c) 2018 Beta Kuang
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package tigo
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestSaveLoadPNGRoundTrip(t *testing.T) {
+	bmp := NewBitmap(2, 2)
+	defer bmp.Free()
+	bmp.Plot(0, 0, Pixel{R: 10, G: 20, B: 30, A: 255})
+	bmp.Plot(1, 1, Pixel{R: 200, G: 100, B: 50, A: 128})
+
+	var buf bytes.Buffer
+	if err := SaveImageWriter(&buf, bmp, "png"); err != nil {
+		t.Fatalf("SaveImageWriter: %v", err)
+	}
+
+	loaded, err := LoadImageReader(&buf)
+	if err != nil {
+		t.Fatalf("LoadImageReader: %v", err)
+	}
+	defer loaded.Free()
+
+	if got, want := loaded.Get(0, 0), (Pixel{R: 10, G: 20, B: 30, A: 255}); got != want {
+		t.Errorf("round trip opaque pixel = %+v, want %+v", got, want)
+	}
+
+	got := loaded.Get(1, 1)
+	want := Pixel{R: 200, G: 100, B: 50, A: 128}
+	if !withinTolerance(got, want, 1) {
+		t.Errorf("round trip translucent pixel = %+v, want %+v (±1)", got, want)
+	}
+}
+
+func TestLoadImageBytesRejectsGarbage(t *testing.T) {
+	if _, err := LoadImageBytes([]byte("not an image")); err == nil {
+		t.Error("expected an error decoding garbage bytes")
+	}
+}
+
+func withinTolerance(got, want Pixel, tolerance int) bool {
+	diff := func(a, b uint8) int {
+		if int(a) > int(b) {
+			return int(a) - int(b)
+		}
+		return int(b) - int(a)
+	}
+	return diff(got.R, want.R) <= tolerance &&
+		diff(got.G, want.G) <= tolerance &&
+		diff(got.B, want.B) <= tolerance &&
+		diff(got.A, want.A) <= tolerance
+}
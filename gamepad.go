@@ -0,0 +1,86 @@
+// Copyright (c) 2018 Beta Kuang
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package tigo
+
+// GamepadButton represents a standard gamepad button, named after their
+// Xbox-layout equivalents.
+type GamepadButton int
+
+// Gamepad button constants.
+const (
+	GamepadA GamepadButton = iota
+	GamepadB
+	GamepadX
+	GamepadY
+	GamepadLeftBumper
+	GamepadRightBumper
+	GamepadBack
+	GamepadStart
+	GamepadGuide
+	GamepadLeftThumb
+	GamepadRightThumb
+	// GamepadDPadUp, GamepadDPadRight, GamepadDPadDown, and GamepadDPadLeft
+	// are read from the D-pad hat axes on Linux and from XInput's D-pad
+	// buttons on Windows. On macOS every button, these included, is the
+	// device's HID button element at that index, in the order the device
+	// reports them.
+	GamepadDPadUp
+	GamepadDPadRight
+	GamepadDPadDown
+	GamepadDPadLeft
+)
+
+// GamepadAxis represents a standard gamepad analog axis.
+type GamepadAxis int
+
+// Gamepad axis constants.
+const (
+	GamepadLeftX GamepadAxis = iota
+	GamepadLeftY
+	GamepadRightX
+	GamepadRightY
+	GamepadLeftTrigger
+	GamepadRightTrigger
+)
+
+// Gamepad represents a gamepad connected to the system.
+// The native backend (the Linux joystick API, XInput, or IOHIDManager,
+// depending on GOOS) is
+// implemented behind build tags; see gamepad_*.go.
+type Gamepad struct {
+	index int
+}
+
+// Gamepads returns the currently connected gamepads, polling the native
+// backend for the latest connection state. On Linux and macOS a gamepad
+// that has been unplugged stays listed.
+func Gamepads() []*Gamepad {
+	gamepadPoll()
+
+	var pads []*Gamepad
+	for i := 0; i < gamepadCount(); i++ {
+		if gamepadConnected(i) {
+			pads = append(pads, &Gamepad{index: i})
+		}
+	}
+	return pads
+}
+
+// Name returns the gamepad's manufacturer-reported name.
+func (pad *Gamepad) Name() string {
+	return gamepadName(pad.index)
+}
+
+// Button returns true if the given button is currently held down.
+func (pad *Gamepad) Button(button GamepadButton) bool {
+	return gamepadButton(pad.index, button)
+}
+
+// Axis returns the current value of the given analog axis.
+// Sticks range from -1 to 1; triggers range from 0 to 1.
+func (pad *Gamepad) Axis(axis GamepadAxis) float32 {
+	return gamepadAxis(pad.index, axis)
+}
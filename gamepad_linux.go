@@ -0,0 +1,34 @@
+// Copyright (c) 2018 Beta Kuang
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package tigo
+
+// #include "gamepad/gamepad_linux.h"
+// #include "gamepad/gamepad_linux.c"
+import "C"
+
+func gamepadPoll() {
+	C.tigoGamepadPoll()
+}
+
+func gamepadCount() int {
+	return int(C.tigoGamepadCount())
+}
+
+func gamepadConnected(index int) bool {
+	return C.tigoGamepadConnected(C.int(index)) != 0
+}
+
+func gamepadName(index int) string {
+	return C.GoString(C.tigoGamepadName(C.int(index)))
+}
+
+func gamepadButton(index int, button GamepadButton) bool {
+	return C.tigoGamepadButton(C.int(index), C.int(button)) != 0
+}
+
+func gamepadAxis(index int, axis GamepadAxis) float32 {
+	return float32(C.tigoGamepadAxis(C.int(index), C.int(axis)))
+}
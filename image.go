@@ -0,0 +1,84 @@
+// Copyright (c) 2018 Beta Kuang
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package tigo
+
+// #include "tigr/tigr.h"
+import "C"
+import (
+	"image"
+	"image/color"
+	"unsafe"
+)
+
+// RGBA implements color.Color for Pixel. Pixel holds straight (non-premultiplied)
+// alpha, like color.NRGBA, so the returned channels are alpha-premultiplied per
+// the color.Color contract.
+func (p Pixel) RGBA() (r, g, b, a uint32) {
+	return color.NRGBA{R: p.R, G: p.G, B: p.B, A: p.A}.RGBA()
+}
+
+// ColorModel returns the Bitmap's color model. Pixel stores straight alpha, so
+// this is color.NRGBAModel rather than color.RGBAModel.
+func (bmp *Bitmap) ColorModel() color.Model {
+	return color.NRGBAModel
+}
+
+// Bounds returns the domain for which At can return non-zero color.
+func (bmp *Bitmap) Bounds() image.Rectangle {
+	tigr := (*C.Tigr)(bmp.cBitmap)
+	return image.Rect(0, 0, int(tigr.w), int(tigr.h))
+}
+
+// At returns the color of the pixel at (x, y).
+func (bmp *Bitmap) At(x, y int) color.Color {
+	p := bmp.Get(x, y)
+	return color.NRGBA{R: p.R, G: p.G, B: p.B, A: p.A}
+}
+
+// Pixels returns a slice of Pixel that aliases the bitmap's underlying pixel buffer.
+// Modifying the returned slice modifies the bitmap directly. The slice is only
+// valid until the next call to Resize, which may reallocate the buffer.
+func (bmp *Bitmap) Pixels() []Pixel {
+	tigr := (*C.Tigr)(bmp.cBitmap)
+	return unsafe.Slice((*Pixel)(unsafe.Pointer(tigr.pix)), int(tigr.w)*int(tigr.h))
+}
+
+// RGBA converts a bitmap into a standard library *image.RGBA, premultiplying
+// Pixel's straight alpha as *image.RGBA requires. It reads through Pixels
+// rather than Get to avoid a cgo call per pixel.
+func (bmp *Bitmap) RGBA() *image.RGBA {
+	bounds := bmp.Bounds()
+	w := bounds.Dx()
+	pixels := bmp.Pixels()
+	img := image.NewRGBA(bounds)
+
+	for y := 0; y < bounds.Dy(); y++ {
+		for x := 0; x < w; x++ {
+			p := pixels[y*w+x]
+			r, g, b, a := (color.NRGBA{R: p.R, G: p.G, B: p.B, A: p.A}).RGBA()
+			img.SetRGBA(x, y, color.RGBA{R: uint8(r >> 8), G: uint8(g >> 8), B: uint8(b >> 8), A: uint8(a >> 8)})
+		}
+	}
+	return img
+}
+
+// FromImage creates a new off-screen bitmap from a standard library image.Image,
+// un-premultiplying alpha since Pixel stores straight RGB. It writes through
+// Pixels rather than Plot to avoid a cgo call per pixel.
+func FromImage(img image.Image) *Bitmap {
+	bounds := img.Bounds()
+	w, h := bounds.Dx(), bounds.Dy()
+	bmp := NewBitmap(w, h)
+	pixels := bmp.Pixels()
+
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			c := color.NRGBAModel.Convert(img.At(bounds.Min.X+x, bounds.Min.Y+y)).(color.NRGBA)
+			pixels[y*w+x] = Pixel{R: c.R, G: c.G, B: c.B, A: c.A}
+		}
+	}
+	return bmp
+}
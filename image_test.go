@@ -0,0 +1,38 @@
+// Copyright (c) 2018 Beta Kuang
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package tigo
+
+import (
+	"image"
+	"image/color"
+	"testing"
+)
+
+func TestFromImagePreservesStraightAlpha(t *testing.T) {
+	src := image.NewNRGBA(image.Rect(0, 0, 1, 1))
+	src.SetNRGBA(0, 0, color.NRGBA{R: 200, G: 10, B: 50, A: 128})
+
+	bmp := FromImage(src)
+	defer bmp.Free()
+
+	got := bmp.Get(0, 0)
+	want := Pixel{R: 200, G: 10, B: 50, A: 128}
+	if got != want {
+		t.Errorf("FromImage round trip = %+v, want %+v", got, want)
+	}
+}
+
+func TestBitmapAtSatisfiesColorContract(t *testing.T) {
+	bmp := NewBitmap(1, 1)
+	defer bmp.Free()
+	bmp.Plot(0, 0, Pixel{R: 200, G: 10, B: 50, A: 128})
+
+	r, g, b, a := bmp.At(0, 0).RGBA()
+	wantR, wantG, wantB, wantA := (color.NRGBA{R: 200, G: 10, B: 50, A: 128}).RGBA()
+	if r != wantR || g != wantG || b != wantB || a != wantA {
+		t.Errorf("At(0, 0).RGBA() = (%d, %d, %d, %d), want (%d, %d, %d, %d)", r, g, b, a, wantR, wantG, wantB, wantA)
+	}
+}
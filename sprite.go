@@ -0,0 +1,244 @@
+// Copyright (c) 2018 Beta Kuang
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package tigo
+
+import (
+	"image"
+	"math"
+)
+
+// Sprite wraps a source bitmap plus a sub-rectangle to draw from.
+type Sprite struct {
+	Source *Bitmap
+	Rect   image.Rectangle
+}
+
+// NewSprite creates a sprite covering the whole source bitmap.
+func NewSprite(source *Bitmap) *Sprite {
+	return &Sprite{Source: source, Rect: source.Bounds()}
+}
+
+// Filter selects the sampling method a SpriteBatch uses when scaling or rotating.
+type Filter int
+
+const (
+	// FilterNearest samples the nearest source pixel.
+	FilterNearest Filter = iota
+	// FilterBilinear interpolates between the four nearest source pixels.
+	FilterBilinear
+)
+
+// DrawParams describes how a single sprite draw should be transformed.
+type DrawParams struct {
+	X, Y             float32
+	OriginX, OriginY float32
+	ScaleX, ScaleY   float32
+	Rotation         float32
+	Tint             Pixel
+	Alpha            float32
+}
+
+// spriteDraw is one accumulated draw call, queued until the batch is flushed.
+type spriteDraw struct {
+	sprite *Sprite
+	params DrawParams
+}
+
+// SpriteBatch accumulates sprite draws for a frame and flushes them with a
+// single software rasterization pass.
+type SpriteBatch struct {
+	Filter Filter
+	draws  []spriteDraw
+}
+
+// NewSpriteBatch creates an empty sprite batch using the given sampling filter.
+func NewSpriteBatch(filter Filter) *SpriteBatch {
+	return &SpriteBatch{Filter: filter}
+}
+
+// Draw queues a sprite draw with the given parameters.
+func (batch *SpriteBatch) Draw(sprite *Sprite, params DrawParams) {
+	if params.ScaleX == 0 {
+		params.ScaleX = 1
+	}
+	if params.ScaleY == 0 {
+		params.ScaleY = 1
+	}
+	if params.Alpha == 0 {
+		params.Alpha = 1
+	}
+	batch.draws = append(batch.draws, spriteDraw{sprite: sprite, params: params})
+}
+
+// Flush rasterizes all queued draws onto dest and clears the batch.
+func (batch *SpriteBatch) Flush(dest *Bitmap) {
+	pixels := dest.Pixels()
+	bounds := dest.Bounds()
+
+	for _, d := range batch.draws {
+		drawSprite(pixels, bounds.Dx(), bounds.Dy(), d.sprite, d.params, batch.Filter)
+	}
+	batch.draws = batch.draws[:0]
+}
+
+// drawSprite rasterizes a single sprite draw using a float32 affine
+// transform from destination space back into source space.
+func drawSprite(dst []Pixel, dstW, dstH int, sprite *Sprite, p DrawParams, filter Filter) {
+	src := sprite.Source
+	srcPixels := src.Pixels()
+	srcBounds := src.Bounds()
+	srcW, srcH := srcBounds.Dx(), srcBounds.Dy()
+
+	rectW, rectH := sprite.Rect.Dx(), sprite.Rect.Dy()
+	if rectW <= 0 || rectH <= 0 {
+		return
+	}
+
+	sin, cos := sinCos(p.Rotation)
+
+	// Compute the bounding box of the transformed quad in destination space.
+	corners := [4][2]float32{
+		transformCorner(-p.OriginX, -p.OriginY, p.ScaleX, p.ScaleY, sin, cos, p.X, p.Y),
+		transformCorner(float32(rectW)-p.OriginX, -p.OriginY, p.ScaleX, p.ScaleY, sin, cos, p.X, p.Y),
+		transformCorner(-p.OriginX, float32(rectH)-p.OriginY, p.ScaleX, p.ScaleY, sin, cos, p.X, p.Y),
+		transformCorner(float32(rectW)-p.OriginX, float32(rectH)-p.OriginY, p.ScaleX, p.ScaleY, sin, cos, p.X, p.Y),
+	}
+	minX, minY, maxX, maxY := corners[0][0], corners[0][1], corners[0][0], corners[0][1]
+	for _, c := range corners[1:] {
+		minX, maxX = minF(minX, c[0]), maxF(maxX, c[0])
+		minY, maxY = minF(minY, c[1]), maxF(maxY, c[1])
+	}
+
+	startX, startY := clampInt(int(minX), 0, dstW), clampInt(int(minY), 0, dstH)
+	endX, endY := clampInt(int(maxX)+1, 0, dstW), clampInt(int(maxY)+1, 0, dstH)
+
+	for dy := startY; dy < endY; dy++ {
+		for dx := startX; dx < endX; dx++ {
+			// Invert the transform to find the source-space sample point.
+			lx, ly := float32(dx)-p.X, float32(dy)-p.Y
+			sx := (lx*cos + ly*sin) / p.ScaleX
+			sy := (-lx*sin + ly*cos) / p.ScaleY
+			sx += p.OriginX
+			sy += p.OriginY
+			if sx < 0 || sy < 0 || sx >= float32(rectW) || sy >= float32(rectH) {
+				continue
+			}
+
+			var sample Pixel
+			switch filter {
+			case FilterBilinear:
+				sample = sampleBilinear(srcPixels, srcW, srcH, sprite.Rect, sx, sy)
+			default:
+				sample = samplePixel(srcPixels, srcW, srcH, sprite.Rect.Min.X+int(sx), sprite.Rect.Min.Y+int(sy))
+			}
+
+			sample = tintPixel(sample, p.Tint)
+			// blendPixel already multiplies by sample.A, so coverage only
+			// carries the batch-level alpha fade-out.
+			coverage := uint8(clampF(p.Alpha*255, 0, 255))
+			dst[dy*dstW+dx] = blendPixel(dst[dy*dstW+dx], sample, coverage)
+		}
+	}
+}
+
+// sinCos returns the sine and cosine of an angle given in radians.
+func sinCos(radians float32) (sin, cos float32) {
+	s, c := math.Sincos(float64(radians))
+	return float32(s), float32(c)
+}
+
+func transformCorner(x, y, scaleX, scaleY, sin, cos, originX, originY float32) [2]float32 {
+	x *= scaleX
+	y *= scaleY
+	return [2]float32{x*cos - y*sin + originX, x*sin + y*cos + originY}
+}
+
+func samplePixel(pixels []Pixel, w, h int, x, y int) Pixel {
+	if x < 0 || y < 0 || x >= w || y >= h {
+		return Pixel{}
+	}
+	return pixels[y*w+x]
+}
+
+func sampleBilinear(pixels []Pixel, w, h int, rect image.Rectangle, sx, sy float32) Pixel {
+	x0, y0 := rect.Min.X+int(sx), rect.Min.Y+int(sy)
+	fx, fy := sx-float32(int(sx)), sy-float32(int(sy))
+
+	// Clamp each tap to the sprite's own sub-rect, not just the whole source
+	// bitmap, so bilinear sampling near a sprite's edge doesn't bleed in
+	// neighboring tiles from the rest of a packed atlas.
+	c00 := samplePixelInRect(pixels, w, h, rect, x0, y0)
+	c10 := samplePixelInRect(pixels, w, h, rect, x0+1, y0)
+	c01 := samplePixelInRect(pixels, w, h, rect, x0, y0+1)
+	c11 := samplePixelInRect(pixels, w, h, rect, x0+1, y0+1)
+
+	return Pixel{
+		R: lerpChannel(c00.R, c10.R, c01.R, c11.R, fx, fy),
+		G: lerpChannel(c00.G, c10.G, c01.G, c11.G, fx, fy),
+		B: lerpChannel(c00.B, c10.B, c01.B, c11.B, fx, fy),
+		A: lerpChannel(c00.A, c10.A, c01.A, c11.A, fx, fy),
+	}
+}
+
+// samplePixelInRect clamps (x, y) to rect before sampling, so edge taps
+// repeat the sprite's own border pixels instead of reading past it.
+func samplePixelInRect(pixels []Pixel, w, h int, rect image.Rectangle, x, y int) Pixel {
+	x = clampInt(x, rect.Min.X, rect.Max.X-1)
+	y = clampInt(y, rect.Min.Y, rect.Max.Y-1)
+	return samplePixel(pixels, w, h, x, y)
+}
+
+func lerpChannel(c00, c10, c01, c11 uint8, fx, fy float32) uint8 {
+	top := float32(c00)*(1-fx) + float32(c10)*fx
+	bottom := float32(c01)*(1-fx) + float32(c11)*fx
+	return uint8(top*(1-fy) + bottom*fy)
+}
+
+func tintPixel(p, tint Pixel) Pixel {
+	if tint == (Pixel{}) {
+		return p
+	}
+	return Pixel{
+		R: uint8(uint32(p.R) * uint32(tint.R) / 255),
+		G: uint8(uint32(p.G) * uint32(tint.G) / 255),
+		B: uint8(uint32(p.B) * uint32(tint.B) / 255),
+		A: p.A,
+	}
+}
+
+func minF(a, b float32) float32 {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func maxF(a, b float32) float32 {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+func clampF(v, min, max float32) float32 {
+	if v < min {
+		return min
+	}
+	if v > max {
+		return max
+	}
+	return v
+}
+
+func clampInt(v, min, max int) int {
+	if v < min {
+		return min
+	}
+	if v > max {
+		return max
+	}
+	return v
+}
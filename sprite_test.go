@@ -0,0 +1,60 @@
+// Copyright (c) 2018 Beta Kuang
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package tigo
+
+import (
+	"image"
+	"testing"
+)
+
+func TestBlendPixelTranslucent(t *testing.T) {
+	dst := Pixel{R: 0, G: 0, B: 0, A: 0xff}
+	src := Pixel{R: 0xff, G: 0xff, B: 0xff, A: 0x80}
+
+	// coverage=255 means "fully apply src's own alpha", matching how
+	// drawSprite folds a batch-level Alpha fade into coverage without
+	// re-multiplying sample.A a second time.
+	got := blendPixel(dst, src, 255)
+
+	a := uint32(src.A) * 255 / 255
+	want := uint8((uint32(src.R)*a + uint32(dst.R)*(255-a)) / 255)
+	if got.R != want {
+		t.Errorf("blendPixel R = %d, want %d", got.R, want)
+	}
+	if got.R == 0 {
+		t.Errorf("blendPixel should not fully discard a 50%% alpha source, got R = %d", got.R)
+	}
+}
+
+func TestSampleBilinearClampsToSpriteRect(t *testing.T) {
+	// A 4x2 atlas packed with two 2x2 tiles side by side: a red tile at
+	// x=[0,2) and a blue tile at x=[2,4). Sampling near the right edge of
+	// the red tile's rect must not bleed into the blue tile.
+	const w, h = 4, 2
+	red := Pixel{R: 0xff}
+	blue := Pixel{B: 0xff}
+	pixels := []Pixel{
+		red, red, blue, blue,
+		red, red, blue, blue,
+	}
+	rect := image.Rect(0, 0, 2, 2)
+
+	got := sampleBilinear(pixels, w, h, rect, 1.9, 0.5)
+	if got.B != 0 {
+		t.Errorf("sampleBilinear at the sprite's right edge leaked the neighboring tile: got %+v", got)
+	}
+	if got.R == 0 {
+		t.Errorf("sampleBilinear at the sprite's right edge should still sample its own red tile: got %+v", got)
+	}
+}
+
+func TestTransformCornerIdentity(t *testing.T) {
+	sin, cos := sinCos(0)
+	got := transformCorner(4, 6, 1, 1, sin, cos, 10, 20)
+	if got != [2]float32{14, 26} {
+		t.Errorf("transformCorner = %v, want [14 26]", got)
+	}
+}
@@ -44,6 +44,10 @@ const (
 	Window4X
 	// WindowRetina enables retina support on macOS.
 	WindowRetina
+	// WindowNoCursor hides the mouse cursor over a window.
+	WindowNoCursor
+	// WindowFullscreen opens a window in fullscreen mode.
+	WindowFullscreen
 )
 
 // Bitmap represents a bitmap.
@@ -95,6 +99,32 @@ func (bmp *Bitmap) SetPostFX(hBlur, vBlur bool, scanlines, contrast float32) {
 	C.tigrSetPostFX((*C.Tigr)(bmp.cBitmap), C.int(hBlurInt), C.int(vBlurInt), C.float(scanlines), C.float(contrast))
 }
 
+// SetFullscreen toggles fullscreen mode for a window at runtime.
+func (bmp *Bitmap) SetFullscreen(fullscreen bool) {
+	var fullscreenInt int
+	if fullscreen {
+		fullscreenInt = 1
+	}
+
+	C.tigrSetFullscreen((*C.Tigr)(bmp.cBitmap), C.int(fullscreenInt))
+}
+
+// SetCursorVisible shows or hides the mouse cursor over a window.
+func (bmp *Bitmap) SetCursorVisible(visible bool) {
+	var visibleInt int
+	if visible {
+		visibleInt = 1
+	}
+
+	C.tigrSetCursorVisible((*C.Tigr)(bmp.cBitmap), C.int(visibleInt))
+}
+
+// Resize resizes a window's bitmap. It may reallocate the underlying pixel
+// buffer, invalidating any slice previously obtained from Pixels.
+func (bmp *Bitmap) Resize(width, height int) {
+	C.tigrResize((*C.Tigr)(bmp.cBitmap), C.int(width), C.int(height))
+}
+
 // Drawing
 
 // goPixel converts a TPixel from C into a Pixel from Go.
@@ -326,6 +356,16 @@ const (
 	Tick
 )
 
+// MouseButton represents a mouse button bit, as returned by Mouse.
+type MouseButton int
+
+// Mouse button constants.
+const (
+	MouseLeft MouseButton = 1 << iota
+	MouseRight
+	MouseMiddle
+)
+
 // Mouse returns mouse input for a window.
 func (bmp *Bitmap) Mouse() (x, y, buttons int) {
 	var cX, cY, cButtons C.int
@@ -334,6 +374,17 @@ func (bmp *Bitmap) Mouse() (x, y, buttons int) {
 	return
 }
 
+// MouseButtonDown returns true if button is held down, per the bitmask returned by Mouse.
+func (bmp *Bitmap) MouseButtonDown(button MouseButton) bool {
+	_, _, buttons := bmp.Mouse()
+	return buttons&int(button) != 0
+}
+
+// MouseWheel returns the mouse wheel's movement since the last call, in notches.
+func (bmp *Bitmap) MouseWheel() float32 {
+	return float32(C.tigrMouseWheel((*C.Tigr)(bmp.cBitmap)))
+}
+
 // KeyDown returns true if a key is pressed for a window.
 // KeyDown only tests for the initial press.
 func (bmp *Bitmap) KeyDown(key Key) bool {
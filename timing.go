@@ -0,0 +1,56 @@
+// Copyright (c) 2018 Beta Kuang
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package tigo
+
+// #include "tigr/tigr.h"
+import "C"
+import "time"
+
+// Width returns a bitmap's width in pixels.
+// Reading it from the underlying Tigr struct lets games respond to
+// WindowAuto resizes.
+func (bmp *Bitmap) Width() int {
+	return int((*C.Tigr)(bmp.cBitmap).w)
+}
+
+// Height returns a bitmap's height in pixels.
+func (bmp *Bitmap) Height() int {
+	return int((*C.Tigr)(bmp.cBitmap).h)
+}
+
+// Time returns the number of seconds since the last call to Time.
+// tigr's underlying clock is process-global, not per-window, so calling Time
+// on two different Bitmaps shares the same timer rather than tracking
+// independent deltas.
+func (bmp *Bitmap) Time() float64 {
+	return float64(C.tigrTime())
+}
+
+// Run drives a window at the given frame rate, calling update with the
+// elapsed time in seconds since the previous frame, until the window is
+// closed. The window is freed before Run returns. A positive fps caps the
+// frame rate; fps <= 0 runs uncapped. Like Time, the frame clock is process-global, so
+// running two windows at once shares one timer rather than ticking
+// independently.
+func (bmp *Bitmap) Run(fps int, update func(dt float64)) {
+	var frameTime time.Duration
+	if fps > 0 {
+		frameTime = time.Second / time.Duration(fps)
+	}
+
+	for !bmp.Closed() {
+		start := time.Now()
+
+		update(bmp.Time())
+		bmp.Update()
+
+		if elapsed := time.Since(start); elapsed < frameTime {
+			time.Sleep(frameTime - elapsed)
+		}
+	}
+
+	bmp.Free()
+}
@@ -0,0 +1,204 @@
+// Copyright (c) 2018 Beta Kuang
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package tigo
+
+import (
+	"container/list"
+	"image"
+	"image/color"
+	"os"
+
+	"github.com/golang/freetype/truetype"
+	"golang.org/x/image/font"
+	"golang.org/x/image/math/fixed"
+)
+
+// TTFont represents a scalable TrueType/FreeType font, rendered and composited
+// entirely on the Go side.
+type TTFont struct {
+	face   font.Face
+	glyphs *glyphCache
+}
+
+// glyphCacheSize is the number of rasterized glyphs kept per TTFont.
+const glyphCacheSize = 256
+
+// LoadTTF loads a TrueType/FreeType font from a file at the given point size.
+func LoadTTF(path string, size float64) (*TTFont, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	parsed, err := truetype.Parse(data)
+	if err != nil {
+		return nil, err
+	}
+
+	face := truetype.NewFace(parsed, &truetype.Options{Size: size})
+	return &TTFont{face: face, glyphs: newGlyphCache(glyphCacheSize)}, nil
+}
+
+// TextWidth returns the width in pixels of text rendered with font.
+func (ttf *TTFont) TextWidth(text string) int {
+	// Sum each glyph's rounded-up advance, the same pen steps PrintTTF takes.
+	width := 0
+	for _, r := range text {
+		width += ttf.glyph(r).advance.Ceil()
+	}
+	return width
+}
+
+// TextHeight returns the line height in pixels of text rendered with font.
+func (ttf *TTFont) TextHeight(text string) int {
+	metrics := ttf.face.Metrics()
+	return (metrics.Ascent + metrics.Descent).Ceil()
+}
+
+// PrintTTF prints UTF-8 text onto a bitmap using a TTFont, compositing glyphs
+// directly into the bitmap's pixel buffer.
+func (bmp *Bitmap) PrintTTF(font *TTFont, x, y int, color Pixel, text string) {
+	pixels := bmp.Pixels()
+	bounds := bmp.Bounds()
+	ascent := font.face.Metrics().Ascent.Ceil()
+
+	penX := x
+	for _, r := range text {
+		g := font.glyph(r)
+		drawAlphaMask(pixels, bounds.Dx(), bounds.Dy(), g.mask, penX+g.left, y+ascent+g.top, color)
+		penX += g.advance.Ceil()
+	}
+}
+
+// drawAlphaMask blends an alpha mask into a pixel buffer at (x, y), tinted by color.
+func drawAlphaMask(pixels []Pixel, width, height int, mask *image.Alpha, x, y int, tint Pixel) {
+	if mask == nil {
+		return
+	}
+
+	maskBounds := mask.Bounds()
+	for my := maskBounds.Min.Y; my < maskBounds.Max.Y; my++ {
+		dy := y + my - maskBounds.Min.Y
+		if dy < 0 || dy >= height {
+			continue
+		}
+		for mx := maskBounds.Min.X; mx < maskBounds.Max.X; mx++ {
+			dx := x + mx - maskBounds.Min.X
+			if dx < 0 || dx >= width {
+				continue
+			}
+			a := mask.AlphaAt(mx, my).A
+			if a == 0 {
+				continue
+			}
+			dst := &pixels[dy*width+dx]
+			*dst = blendPixel(*dst, tint, a)
+		}
+	}
+}
+
+// blendPixel alpha-blends src over dst, scaling src's alpha by coverage (0-255).
+func blendPixel(dst, src Pixel, coverage uint8) Pixel {
+	a := uint32(src.A) * uint32(coverage) / 255
+	inv := 255 - a
+	return Pixel{
+		R: uint8((uint32(src.R)*a + uint32(dst.R)*inv) / 255),
+		G: uint8((uint32(src.G)*a + uint32(dst.G)*inv) / 255),
+		B: uint8((uint32(src.B)*a + uint32(dst.B)*inv) / 255),
+		A: uint8((a + uint32(dst.A)*inv/255)),
+	}
+}
+
+// glyphKey identifies a cached glyph by rune; each TTFont has its own cache,
+// so size is implied.
+type glyphKey rune
+
+// glyphEntry is a rasterized glyph, ready to composite.
+type glyphEntry struct {
+	mask    *image.Alpha
+	left    int
+	top     int
+	advance fixed.Int26_6
+}
+
+// glyphCache is a fixed-capacity LRU cache of rasterized glyphs.
+type glyphCache struct {
+	capacity int
+	ll       *list.List
+	items    map[glyphKey]*list.Element
+}
+
+type glyphCacheItem struct {
+	key   glyphKey
+	entry *glyphEntry
+}
+
+func newGlyphCache(capacity int) *glyphCache {
+	return &glyphCache{
+		capacity: capacity,
+		ll:       list.New(),
+		items:    make(map[glyphKey]*list.Element, capacity),
+	}
+}
+
+func (c *glyphCache) get(key glyphKey) (*glyphEntry, bool) {
+	elem, ok := c.items[key]
+	if !ok {
+		return nil, false
+	}
+	c.ll.MoveToFront(elem)
+	return elem.Value.(*glyphCacheItem).entry, true
+}
+
+func (c *glyphCache) put(key glyphKey, entry *glyphEntry) {
+	elem := c.ll.PushFront(&glyphCacheItem{key: key, entry: entry})
+	c.items[key] = elem
+	for c.ll.Len() > c.capacity {
+		oldest := c.ll.Back()
+		if oldest == nil {
+			break
+		}
+		c.ll.Remove(oldest)
+		delete(c.items, oldest.Value.(*glyphCacheItem).key)
+	}
+}
+
+// glyph rasterizes (or fetches from cache) the glyph for r.
+func (ttf *TTFont) glyph(r rune) *glyphEntry {
+	key := glyphKey(r)
+	if entry, ok := ttf.glyphs.get(key); ok {
+		return entry
+	}
+
+	bounds, advance, ok := ttf.face.GlyphBounds(r)
+	if !ok {
+		entry := &glyphEntry{}
+		ttf.glyphs.put(key, entry)
+		return entry
+	}
+
+	w, h := (bounds.Max.X - bounds.Min.X).Ceil(), (bounds.Max.Y - bounds.Min.Y).Ceil()
+	var mask *image.Alpha
+	if w > 0 && h > 0 {
+		mask = image.NewAlpha(image.Rect(0, 0, w, h))
+		drawer := &font.Drawer{
+			Dst:  mask,
+			Src:  image.NewUniform(color.Alpha{A: 0xff}),
+			Face: ttf.face,
+			Dot:  fixed.Point26_6{X: -bounds.Min.X, Y: -bounds.Min.Y},
+		}
+		drawer.DrawString(string(r))
+	}
+
+	entry := &glyphEntry{
+		mask:    mask,
+		left:    bounds.Min.X.Floor(),
+		top:     bounds.Min.Y.Floor(),
+		advance: advance,
+	}
+	ttf.glyphs.put(key, entry)
+	return entry
+}
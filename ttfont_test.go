@@ -0,0 +1,36 @@
+// Copyright (c) 2018 Beta Kuang
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package tigo
+
+import "testing"
+
+func TestGlyphCacheEvictsLeastRecentlyUsed(t *testing.T) {
+	cache := newGlyphCache(2)
+
+	a := &glyphEntry{left: 1}
+	b := &glyphEntry{left: 2}
+	c := &glyphEntry{left: 3}
+
+	cache.put(glyphKey('a'), a)
+	cache.put(glyphKey('b'), b)
+
+	// Touch 'a' so 'b' becomes the least recently used entry.
+	if _, ok := cache.get(glyphKey('a')); !ok {
+		t.Fatalf("expected 'a' to be cached")
+	}
+
+	cache.put(glyphKey('c'), c)
+
+	if _, ok := cache.get(glyphKey('b')); ok {
+		t.Errorf("expected 'b' to have been evicted")
+	}
+	if entry, ok := cache.get(glyphKey('a')); !ok || entry != a {
+		t.Errorf("expected 'a' to still be cached")
+	}
+	if entry, ok := cache.get(glyphKey('c')); !ok || entry != c {
+		t.Errorf("expected 'c' to be cached")
+	}
+}